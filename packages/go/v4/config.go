@@ -0,0 +1,26 @@
+package kreuzberg
+
+// PageConfig controls per-page extraction behavior: whether pages are
+// extracted individually, whether page markers are inserted between them,
+// and how those markers are formatted.
+//
+// Marker rendering happens entirely on the Go side: MarkerFormat and
+// Formatter are never sent to the FFI layer, which only segments the
+// document into pages.
+type PageConfig struct {
+	ExtractPages      *bool   `json:"extract_pages,omitempty"`
+	InsertPageMarkers *bool   `json:"insert_page_markers,omitempty"`
+	MarkerFormat      *string `json:"marker_format,omitempty"`
+
+	// Formatter controls how page markers are rendered. It takes
+	// precedence over MarkerFormat, which remains a shortcut that builds
+	// a TemplateFormatter from its "{page_num}" placeholder string.
+	Formatter PageMarkerFormatter `json:"-"`
+}
+
+// ExtractionConfig controls how ExtractFileSync, ExtractBytesSync, and
+// their streaming counterparts process a document.
+type ExtractionConfig struct {
+	Pages     *PageConfig             `json:"pages,omitempty"`
+	Embedding *EmbeddingRuntimeConfig `json:"embedding,omitempty"`
+}
@@ -0,0 +1,140 @@
+package kreuzberg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EmbeddingRuntimeConfig controls where embedding model files are fetched
+// from and cached by the ONNX FFI bridge. Set it on
+// ExtractionConfig.Embedding to control embedding behavior during
+// extraction, or pass it to PrefetchEmbeddingPreset to warm the cache
+// ahead of time.
+type EmbeddingRuntimeConfig struct {
+	// CacheDir is where downloaded model files are stored.
+	// DefaultEmbeddingRuntimeConfig sets it to $KREUZBERG_MODEL_CACHE, or
+	// os.TempDir()/kreuzberg/models if that is unset. An empty CacheDir is
+	// not filled in: PrefetchEmbeddingPreset then looks for models relative
+	// to the working directory.
+	CacheDir string `json:"cache_dir,omitempty"`
+	// AllowDownload permits fetching missing model files over the network.
+	// Ignored (treated as false) when Offline is true.
+	AllowDownload bool `json:"allow_download"`
+	// VerifyChecksum validates cached model files against their published
+	// checksum before use. On a mismatch the files are refetched when
+	// downloading is allowed; otherwise the mismatch is returned as an error.
+	VerifyChecksum bool `json:"verify_checksum"`
+	// MirrorURL overrides the default model download host, for private
+	// mirrors or air-gapped environments with an internal artifact proxy.
+	MirrorURL string `json:"mirror_url,omitempty"`
+	// PresetOverrides maps a preset name to a local model path, bypassing
+	// cache lookup and download entirely for that preset.
+	PresetOverrides map[string]string `json:"preset_overrides,omitempty"`
+	// Offline disables all network I/O. If a preset's model is missing
+	// from CacheDir or PresetOverrides, PrefetchEmbeddingPreset fails fast
+	// with *ErrModelNotCached instead of attempting to fetch it. During
+	// extraction the setting is only passed to the FFI layer, whose errors
+	// are returned as plain errors, not as *ErrModelNotCached.
+	Offline bool `json:"offline"`
+}
+
+// ErrModelNotCached is returned when a preset's model files are not present
+// in the local cache and EmbeddingRuntimeConfig.Offline is set or
+// EmbeddingRuntimeConfig.AllowDownload is false.
+type ErrModelNotCached struct {
+	Preset   string
+	CacheDir string
+}
+
+func (e *ErrModelNotCached) Error() string {
+	return fmt.Sprintf("kreuzberg: embedding preset %q not found in cache %q and downloading is disabled", e.Preset, e.CacheDir)
+}
+
+// DefaultEmbeddingRuntimeConfig builds the runtime config used when
+// ExtractionConfig.Embedding is nil. It mirrors the SKIP_ONNX_TESTS/IS_CI
+// pattern used in the test suite: CI and air-gapped runs default to
+// Offline rather than attempting to download a model.
+func DefaultEmbeddingRuntimeConfig() *EmbeddingRuntimeConfig {
+	offline := os.Getenv("IS_CI") == "true" || os.Getenv("SKIP_ONNX_TESTS") == "true"
+
+	cacheDir := os.Getenv("KREUZBERG_MODEL_CACHE")
+	if cacheDir == "" {
+		cacheDir = filepath.Join(os.TempDir(), "kreuzberg", "models")
+	}
+
+	return &EmbeddingRuntimeConfig{
+		CacheDir:       cacheDir,
+		AllowDownload:  !offline,
+		VerifyChecksum: true,
+		Offline:        offline,
+	}
+}
+
+// modelPath returns where preset's model files should live under cfg's
+// cache, honoring any PresetOverrides entry.
+func (cfg *EmbeddingRuntimeConfig) modelPath(preset *EmbeddingPreset) string {
+	if override, ok := cfg.PresetOverrides[preset.Name]; ok {
+		return override
+	}
+	return filepath.Join(cfg.CacheDir, preset.Name)
+}
+
+// PrefetchEmbeddingPreset ensures the ONNX model files for the named
+// preset are present in cfg's cache, downloading them first if
+// cfg.AllowDownload permits it. Call it at process start to warm the
+// cache so the first real extraction doesn't pay the download cost.
+//
+// If cfg is nil, DefaultEmbeddingRuntimeConfig is used. If cfg.Offline is
+// true and the model is missing, PrefetchEmbeddingPreset returns
+// *ErrModelNotCached rather than attempting network I/O.
+func PrefetchEmbeddingPreset(name string, cfg *EmbeddingRuntimeConfig) error {
+	if cfg == nil {
+		cfg = DefaultEmbeddingRuntimeConfig()
+	}
+
+	preset, err := GetEmbeddingPreset(name)
+	if err != nil {
+		return err
+	}
+
+	modelPath := cfg.modelPath(preset)
+	cached := modelFilesExist(modelPath)
+
+	if cached {
+		if !cfg.VerifyChecksum {
+			return nil
+		}
+		verifyErr := ffiVerifyModelChecksum(modelPath, preset.Name)
+		if verifyErr == nil {
+			return nil
+		}
+		if cfg.Offline || !cfg.AllowDownload {
+			return verifyErr
+		}
+		// A mismatched checksum on a downloadable, non-offline cache means
+		// the cached files are corrupt or stale rather than missing;
+		// refetch them instead of surfacing the mismatch as a hard error.
+		return ffiDownloadModel(modelPath, preset.Name, cfg.MirrorURL)
+	}
+
+	if cfg.Offline || !cfg.AllowDownload {
+		return &ErrModelNotCached{Preset: name, CacheDir: cfg.CacheDir}
+	}
+
+	return ffiDownloadModel(modelPath, preset.Name, cfg.MirrorURL)
+}
+
+// modelFilesExist reports whether path (a PresetOverrides file or a cache
+// directory) already has model content on disk.
+func modelFilesExist(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if !info.IsDir() {
+		return true
+	}
+	entries, err := os.ReadDir(path)
+	return err == nil && len(entries) > 0
+}
@@ -0,0 +1,78 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestPrefetchEmbeddingPresetOffline tests that PrefetchEmbeddingPreset
+// fails fast with ErrModelNotCached when Offline is set and nothing has
+// been cached yet, instead of attempting network I/O.
+func TestPrefetchEmbeddingPresetOffline(t *testing.T) {
+	cfg := &EmbeddingRuntimeConfig{
+		CacheDir: t.TempDir(),
+		Offline:  true,
+	}
+
+	err := PrefetchEmbeddingPreset("balanced", cfg)
+	if err == nil {
+		t.Fatalf("expected ErrModelNotCached, got nil")
+	}
+	if _, ok := err.(*ErrModelNotCached); !ok {
+		t.Fatalf("expected *ErrModelNotCached, got %T: %v", err, err)
+	}
+}
+
+// TestPrefetchEmbeddingPresetUnknownPreset tests that PrefetchEmbeddingPreset
+// surfaces the same error as GetEmbeddingPreset for an unknown preset name.
+func TestPrefetchEmbeddingPresetUnknownPreset(t *testing.T) {
+	cfg := &EmbeddingRuntimeConfig{CacheDir: t.TempDir(), Offline: true}
+
+	err := PrefetchEmbeddingPreset("nonexistent", cfg)
+	if err == nil {
+		t.Fatalf("expected error for unknown preset")
+	}
+	_, wantErr := GetEmbeddingPreset("nonexistent")
+	if err.Error() != wantErr.Error() {
+		t.Fatalf("error = %q, want %q", err, wantErr)
+	}
+}
+
+// TestDefaultEmbeddingRuntimeConfigHonorsCIEnv tests that
+// DefaultEmbeddingRuntimeConfig mirrors the IS_CI/SKIP_ONNX_TESTS pattern
+// used elsewhere in the test suite by defaulting to offline mode.
+func TestDefaultEmbeddingRuntimeConfigHonorsCIEnv(t *testing.T) {
+	t.Setenv("SKIP_ONNX_TESTS", "true")
+
+	cfg := DefaultEmbeddingRuntimeConfig()
+	if !cfg.Offline {
+		t.Fatalf("expected Offline=true when SKIP_ONNX_TESTS=true")
+	}
+	if cfg.AllowDownload {
+		t.Fatalf("expected AllowDownload=false when Offline=true")
+	}
+}
+
+// TestMarshalWireConfigAppliesEmbeddingDefault tests that a nil
+// ExtractionConfig.Embedding is substituted with
+// DefaultEmbeddingRuntimeConfig before reaching the FFI layer, so the
+// CI/air-gapped Offline default actually takes effect during extraction.
+func TestMarshalWireConfigAppliesEmbeddingDefault(t *testing.T) {
+	t.Setenv("SKIP_ONNX_TESTS", "true")
+
+	cfgJSON, _, err := marshalWireConfig(&ExtractionConfig{})
+	if err != nil {
+		t.Fatalf("marshalWireConfig: %v", err)
+	}
+
+	var wire ExtractionConfig
+	if err := json.Unmarshal(cfgJSON, &wire); err != nil {
+		t.Fatalf("unmarshal wire config: %v", err)
+	}
+	if wire.Embedding == nil {
+		t.Fatalf("expected Embedding to be defaulted, got nil")
+	}
+	if !wire.Embedding.Offline {
+		t.Fatalf("expected defaulted Embedding.Offline=true under SKIP_ONNX_TESTS")
+	}
+}
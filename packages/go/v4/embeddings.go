@@ -0,0 +1,52 @@
+package kreuzberg
+
+import "fmt"
+
+// EmbeddingPreset describes a named embedding model configuration that can
+// be selected without hand-rolling model parameters.
+type EmbeddingPreset struct {
+	Name        string `json:"name"`
+	ModelName   string `json:"model_name"`
+	Description string `json:"description"`
+	Dimensions  int    `json:"dimensions"`
+}
+
+var embeddingPresets = []EmbeddingPreset{
+	{
+		Name:        "fast",
+		ModelName:   "all-MiniLM-L6-v2",
+		Description: "Smallest and fastest preset; best for high-volume, latency-sensitive pipelines.",
+		Dimensions:  384,
+	},
+	{
+		Name:        "balanced",
+		ModelName:   "bge-small-en-v1.5",
+		Description: "Default preset; balances accuracy and speed for most workloads.",
+		Dimensions:  384,
+	},
+	{
+		Name:        "accurate",
+		ModelName:   "bge-large-en-v1.5",
+		Description: "Highest accuracy preset; larger model, slower inference.",
+		Dimensions:  1024,
+	},
+}
+
+// ListEmbeddingPresets returns the embedding presets built into this
+// release of the SDK.
+func ListEmbeddingPresets() ([]EmbeddingPreset, error) {
+	presets := make([]EmbeddingPreset, len(embeddingPresets))
+	copy(presets, embeddingPresets)
+	return presets, nil
+}
+
+// GetEmbeddingPreset looks up a single preset by name.
+func GetEmbeddingPreset(name string) (*EmbeddingPreset, error) {
+	for i := range embeddingPresets {
+		if embeddingPresets[i].Name == name {
+			preset := embeddingPresets[i]
+			return &preset, nil
+		}
+	}
+	return nil, fmt.Errorf("kreuzberg: unknown embedding preset %q", name)
+}
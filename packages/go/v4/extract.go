@@ -0,0 +1,262 @@
+package kreuzberg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+)
+
+// ExtractFileSync extracts the full content of the document at path,
+// blocking until the FFI layer has produced every page.
+func ExtractFileSync(path string, cfg *ExtractionConfig) (*ExtractResult, error) {
+	cfgJSON, formatter, err := marshalWireConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := ffiExtractFile(path, cfgJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExtractResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("kreuzberg: unmarshal extraction result: %w", err)
+	}
+	applyPageMarkers(cfg, formatter, result.Pages)
+	return &result, nil
+}
+
+// ExtractBytesSync extracts the full content of an in-memory document.
+// mimeHint tells the FFI layer how to parse data when it cannot be
+// inferred from a file extension.
+func ExtractBytesSync(data []byte, mimeHint string, cfg *ExtractionConfig) (*ExtractResult, error) {
+	cfgJSON, formatter, err := marshalWireConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := ffiExtractBytes(data, mimeHint, cfgJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ExtractResult
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, fmt.Errorf("kreuzberg: unmarshal extraction result: %w", err)
+	}
+	applyPageMarkers(cfg, formatter, result.Pages)
+	return &result, nil
+}
+
+// pageFFIResult is the outcome of a single blocking per-page FFI call, sent
+// back over a channel so callers can select on it against ctx.Done().
+type pageFFIResult struct {
+	raw  []byte
+	done bool
+	err  error
+}
+
+// runPageFFIAsync runs a blocking per-page FFI call on its own goroutine so
+// the caller can select on ctx.Done() while the native call is still in
+// flight, rather than only in between calls. The result channel is
+// buffered so the goroutine never blocks even if the caller stops
+// listening after a cancellation.
+func runPageFFIAsync(call func() ([]byte, bool, error)) <-chan pageFFIResult {
+	result := make(chan pageFFIResult, 1)
+	go func() {
+		raw, done, err := call()
+		result <- pageFFIResult{raw: raw, done: done, err: err}
+	}()
+	return result
+}
+
+// bytesStreamTokenSeq generates the token ExtractBytesStream hands to the
+// FFI layer to identify one in-memory extraction, since unlike a file
+// stream there is no path to key cancellation off of.
+var bytesStreamTokenSeq uint64
+
+func nextBytesStreamToken() string {
+	return fmt.Sprintf("bytes-stream-%d", atomic.AddUint64(&bytesStreamTokenSeq, 1))
+}
+
+// ExtractFileStream extracts path page by page, emitting each PageResult on
+// the returned channel as soon as the FFI layer produces it. This lets
+// callers pipeline large documents into downstream processing (indexing,
+// embedding, ...) without buffering the whole document in memory.
+//
+// Both channels are closed when extraction finishes, ctx is cancelled, or
+// an error occurs; callers should range over pages until it closes and
+// then check errs. Cancelling ctx interrupts a page even while its FFI
+// call is still in flight: the native engine is told via
+// ffiCancelExtraction to abandon whatever page it is currently producing.
+func ExtractFileStream(ctx context.Context, path string, cfg *ExtractionConfig) (<-chan PageResult, <-chan error) {
+	pages := make(chan PageResult)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(pages)
+		defer close(errs)
+
+		cfgJSON, formatter, err := marshalWireConfig(cfg)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		for pageNum := 0; ; pageNum++ {
+			pageNum := pageNum
+			resultCh := runPageFFIAsync(func() ([]byte, bool, error) {
+				return ffiExtractFilePage(path, cfgJSON, pageNum)
+			})
+
+			var res pageFFIResult
+			select {
+			case res = <-resultCh:
+			case <-ctx.Done():
+				ffiCancelExtraction(path)
+				errs <- ctx.Err()
+				return
+			}
+			if res.err != nil {
+				errs <- res.err
+				return
+			}
+			if res.done {
+				return
+			}
+
+			pr, err := decodeStreamedPage(res.raw, cfg, formatter)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case pages <- pr:
+			case <-ctx.Done():
+				ffiCancelExtraction(path)
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return pages, errs
+}
+
+// ExtractBytesStream is the in-memory counterpart of ExtractFileStream. It
+// mints a per-call token to identify its in-flight extraction to the FFI
+// layer, so cancelling ctx can tell the native engine (via
+// ffiCancelBytesExtraction) to abandon the page it is currently producing,
+// the same as ExtractFileStream does for a file path.
+func ExtractBytesStream(ctx context.Context, data []byte, mimeHint string, cfg *ExtractionConfig) (<-chan PageResult, <-chan error) {
+	pages := make(chan PageResult)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(pages)
+		defer close(errs)
+
+		cfgJSON, formatter, err := marshalWireConfig(cfg)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		token := nextBytesStreamToken()
+
+		for pageNum := 0; ; pageNum++ {
+			pageNum := pageNum
+			resultCh := runPageFFIAsync(func() ([]byte, bool, error) {
+				return ffiExtractBytesPage(token, data, mimeHint, cfgJSON, pageNum)
+			})
+
+			var res pageFFIResult
+			select {
+			case res = <-resultCh:
+			case <-ctx.Done():
+				ffiCancelBytesExtraction(token)
+				errs <- ctx.Err()
+				return
+			}
+			if res.err != nil {
+				errs <- res.err
+				return
+			}
+			if res.done {
+				return
+			}
+
+			pr, err := decodeStreamedPage(res.raw, cfg, formatter)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case pages <- pr:
+			case <-ctx.Done():
+				ffiCancelBytesExtraction(token)
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return pages, errs
+}
+
+// marshalWireConfig marshals cfg for the FFI layer and resolves the page
+// marker formatter to apply on the Go side. Pages.MarkerFormat is never
+// sent over the wire: marker rendering is a Go-side concern so the FFI
+// layer only needs to know whether to segment the document into pages.
+func marshalWireConfig(cfg *ExtractionConfig) ([]byte, PageMarkerFormatter, error) {
+	var wire ExtractionConfig
+	var formatter PageMarkerFormatter
+	if cfg != nil {
+		wire = *cfg
+		formatter = resolvePageMarkerFormatter(cfg.Pages)
+		if wire.Pages != nil {
+			pages := *wire.Pages
+			pages.MarkerFormat = nil
+			wire.Pages = &pages
+		}
+	}
+	if wire.Embedding == nil {
+		wire.Embedding = DefaultEmbeddingRuntimeConfig()
+	}
+
+	cfgJSON, err := json.Marshal(&wire)
+	if err != nil {
+		return nil, nil, fmt.Errorf("kreuzberg: marshal extraction config: %w", err)
+	}
+	return cfgJSON, formatter, nil
+}
+
+// decodeStreamedPage unmarshals a single streamed page and, if the caller
+// requested page markers, prefixes its content with the formatted marker.
+func decodeStreamedPage(raw []byte, cfg *ExtractionConfig, formatter PageMarkerFormatter) (PageResult, error) {
+	var pr PageResult
+	if err := json.Unmarshal(raw, &pr); err != nil {
+		return PageResult{}, fmt.Errorf("kreuzberg: unmarshal streamed page: %w", err)
+	}
+	pages := []PageResult{pr}
+	applyPageMarkers(cfg, formatter, pages)
+	return pages[0], nil
+}
+
+// applyPageMarkers prefixes each page's content with its formatted marker,
+// in place, when the caller has both a formatter and InsertPageMarkers set.
+func applyPageMarkers(cfg *ExtractionConfig, formatter PageMarkerFormatter, pages []PageResult) {
+	if cfg == nil || cfg.Pages == nil || formatter == nil {
+		return
+	}
+	if cfg.Pages.InsertPageMarkers == nil || !*cfg.Pages.InsertPageMarkers {
+		return
+	}
+	for i := range pages {
+		pages[i].Content = formatter.Format(pages[i].PageMeta) + pages[i].Content
+	}
+}
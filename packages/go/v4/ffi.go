@@ -0,0 +1,123 @@
+package kreuzberg
+
+/*
+#cgo LDFLAGS: -lkreuzberg_ffi
+#include <stdlib.h>
+#include "kreuzberg_ffi.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// ffiExtractFile invokes the native extraction engine on a file path and
+// returns the raw JSON response emitted by the Rust core.
+func ffiExtractFile(path string, cfgJSON []byte) ([]byte, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	cCfg := C.CString(string(cfgJSON))
+	defer C.free(unsafe.Pointer(cCfg))
+
+	var cErr *C.char
+	cResult := C.kreuzberg_extract_file(cPath, cCfg, &cErr)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return nil, errors.New(C.GoString(cErr))
+	}
+	defer C.free(unsafe.Pointer(cResult))
+	return []byte(C.GoString(cResult)), nil
+}
+
+// ffiExtractBytes invokes the native extraction engine on an in-memory
+// document and returns the raw JSON response emitted by the Rust core.
+func ffiExtractBytes(data []byte, mimeHint string, cfgJSON []byte) ([]byte, error) {
+	cData := C.CBytes(data)
+	defer C.free(cData)
+	cMime := C.CString(mimeHint)
+	defer C.free(unsafe.Pointer(cMime))
+	cCfg := C.CString(string(cfgJSON))
+	defer C.free(unsafe.Pointer(cCfg))
+
+	var cErr *C.char
+	cResult := C.kreuzberg_extract_bytes(
+		(*C.uint8_t)(cData), C.size_t(len(data)), cMime, cCfg, &cErr,
+	)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return nil, errors.New(C.GoString(cErr))
+	}
+	defer C.free(unsafe.Pointer(cResult))
+	return []byte(C.GoString(cResult)), nil
+}
+
+// ffiExtractFilePage pulls a single page from an in-progress file
+// extraction. done is true once the engine has no further pages to emit,
+// at which point raw is empty and should be ignored.
+func ffiExtractFilePage(path string, cfgJSON []byte, pageNum int) (raw []byte, done bool, err error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	cCfg := C.CString(string(cfgJSON))
+	defer C.free(unsafe.Pointer(cCfg))
+
+	var cErr *C.char
+	var cDone C.int
+	cResult := C.kreuzberg_extract_file_page(cPath, cCfg, C.int(pageNum), &cDone, &cErr)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return nil, false, errors.New(C.GoString(cErr))
+	}
+	if cDone != 0 {
+		return nil, true, nil
+	}
+	defer C.free(unsafe.Pointer(cResult))
+	return []byte(C.GoString(cResult)), false, nil
+}
+
+// ffiExtractBytesPage is the in-memory counterpart of ffiExtractFilePage.
+// token identifies this in-memory extraction to the native engine, since
+// unlike a file there is no path to key off of; pass it to
+// ffiCancelBytesExtraction to abandon the same extraction mid-flight.
+func ffiExtractBytesPage(token string, data []byte, mimeHint string, cfgJSON []byte, pageNum int) (raw []byte, done bool, err error) {
+	cToken := C.CString(token)
+	defer C.free(unsafe.Pointer(cToken))
+	cData := C.CBytes(data)
+	defer C.free(cData)
+	cMime := C.CString(mimeHint)
+	defer C.free(unsafe.Pointer(cMime))
+	cCfg := C.CString(string(cfgJSON))
+	defer C.free(unsafe.Pointer(cCfg))
+
+	var cErr *C.char
+	var cDone C.int
+	cResult := C.kreuzberg_extract_bytes_page(
+		cToken, (*C.uint8_t)(cData), C.size_t(len(data)), cMime, cCfg, C.int(pageNum), &cDone, &cErr,
+	)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return nil, false, errors.New(C.GoString(cErr))
+	}
+	if cDone != 0 {
+		return nil, true, nil
+	}
+	defer C.free(unsafe.Pointer(cResult))
+	return []byte(C.GoString(cResult)), false, nil
+}
+
+// ffiCancelExtraction tells the Rust core to abandon an in-flight streamed
+// file extraction, releasing any OCR worker still processing a page.
+func ffiCancelExtraction(path string) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	C.kreuzberg_cancel_extraction(cPath)
+}
+
+// ffiCancelBytesExtraction tells the Rust core to abandon the in-flight
+// in-memory extraction identified by token, releasing any OCR worker still
+// processing a page.
+func ffiCancelBytesExtraction(token string) {
+	cToken := C.CString(token)
+	defer C.free(unsafe.Pointer(cToken))
+	C.kreuzberg_cancel_bytes_extraction(cToken)
+}
@@ -0,0 +1,107 @@
+package kreuzberg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// PageMarkerFormatter renders the marker inserted between pages when
+// PageConfig.InsertPageMarkers is set. Set PageConfig.Formatter to one of
+// the built-in implementations below, or supply a custom one for anything
+// they don't cover.
+type PageMarkerFormatter interface {
+	Format(meta PageMeta) string
+}
+
+// MarkdownHeadingFormatter renders a page marker as a Markdown heading,
+// e.g. "## Page 3 of 10".
+type MarkdownHeadingFormatter struct {
+	// Level is the heading level. Zero defaults to 2.
+	Level int
+}
+
+func (f MarkdownHeadingFormatter) Format(meta PageMeta) string {
+	level := f.Level
+	if level <= 0 {
+		level = 2
+	}
+	return fmt.Sprintf("%s Page %d of %d\n", strings.Repeat("#", level), meta.PageNum, meta.TotalPages)
+}
+
+// HTMLAnchorFormatter renders a page marker as an HTML anchor, for
+// deep-linking into a rendered document.
+type HTMLAnchorFormatter struct{}
+
+func (HTMLAnchorFormatter) Format(meta PageMeta) string {
+	return fmt.Sprintf("<a id=\"page-%d\"></a>\n", meta.PageNum)
+}
+
+// JSONLFormatter renders a page marker as a single-line JSON object
+// describing the page, for JSONL-per-page output.
+type JSONLFormatter struct{}
+
+func (JSONLFormatter) Format(meta PageMeta) string {
+	b, err := json.Marshal(meta)
+	if err != nil {
+		return ""
+	}
+	return string(b) + "\n"
+}
+
+// TemplateFormatter renders a page marker with text/template, with
+// .PageNum, .TotalPages, .SourcePath, .Width, .Height and .MimeType
+// available to the template body.
+type TemplateFormatter struct {
+	tmpl *template.Template
+}
+
+// NewTemplateFormatter parses format as a text/template body.
+func NewTemplateFormatter(format string) (*TemplateFormatter, error) {
+	tmpl, err := template.New("pageMarker").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("kreuzberg: parse page marker template: %w", err)
+	}
+	return &TemplateFormatter{tmpl: tmpl}, nil
+}
+
+func (f *TemplateFormatter) Format(meta PageMeta) string {
+	var buf bytes.Buffer
+	if err := f.tmpl.Execute(&buf, meta); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+// newLegacyMarkerFormatter adapts PageConfig.MarkerFormat's "{page_num}"
+// placeholder to a TemplateFormatter, so the original shortcut keeps
+// working unchanged for callers who haven't switched to Formatter.
+func newLegacyMarkerFormatter(format string) *TemplateFormatter {
+	translated := strings.ReplaceAll(format, "{page_num}", "{{.PageNum}}")
+	f, err := NewTemplateFormatter(translated)
+	if err != nil {
+		// MarkerFormat historically accepted any string with no escaping
+		// rules; fall back to a plain page number rather than failing
+		// extraction over an unparsable legacy format.
+		f, _ = NewTemplateFormatter("{{.PageNum}}")
+	}
+	return f
+}
+
+// resolvePageMarkerFormatter returns the formatter to use for p, preferring
+// an explicit Formatter and falling back to the legacy MarkerFormat
+// shortcut. It returns nil when neither is set.
+func resolvePageMarkerFormatter(p *PageConfig) PageMarkerFormatter {
+	if p == nil {
+		return nil
+	}
+	if p.Formatter != nil {
+		return p.Formatter
+	}
+	if p.MarkerFormat != nil {
+		return newLegacyMarkerFormatter(*p.MarkerFormat)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package kreuzberg
+
+import "testing"
+
+// TestMarkdownHeadingFormatter tests that MarkdownHeadingFormatter renders
+// the expected heading for a given page.
+func TestMarkdownHeadingFormatter(t *testing.T) {
+	f := MarkdownHeadingFormatter{}
+	got := f.Format(PageMeta{PageNum: 3, TotalPages: 10})
+	want := "## Page 3 of 10\n"
+	if got != want {
+		t.Fatalf("Format() = %q, want %q", got, want)
+	}
+}
+
+// TestTemplateFormatter tests that TemplateFormatter renders its fields
+// from PageMeta.
+func TestTemplateFormatter(t *testing.T) {
+	f, err := NewTemplateFormatter("{{.SourcePath}} page {{.PageNum}}/{{.TotalPages}}")
+	if err != nil {
+		t.Fatalf("NewTemplateFormatter: %v", err)
+	}
+
+	got := f.Format(PageMeta{PageNum: 2, TotalPages: 5, SourcePath: "doc.pdf"})
+	want := "doc.pdf page 2/5"
+	if got != want {
+		t.Fatalf("Format() = %q, want %q", got, want)
+	}
+}
+
+// TestLegacyMarkerFormatCompatibility tests that the "{page_num}"
+// placeholder accepted by PageConfig.MarkerFormat still renders correctly
+// once translated to a TemplateFormatter.
+func TestLegacyMarkerFormatCompatibility(t *testing.T) {
+	formatter := resolvePageMarkerFormatter(&PageConfig{
+		MarkerFormat: StringPtr("### Page {page_num} ###"),
+	})
+	if formatter == nil {
+		t.Fatalf("expected a formatter for a legacy MarkerFormat")
+	}
+
+	got := formatter.Format(PageMeta{PageNum: 4})
+	want := "### Page 4 ###"
+	if got != want {
+		t.Fatalf("Format() = %q, want %q", got, want)
+	}
+}
+
+// TestResolvePageMarkerFormatterPrefersFormatter tests that an explicit
+// Formatter takes precedence over the legacy MarkerFormat shortcut.
+func TestResolvePageMarkerFormatterPrefersFormatter(t *testing.T) {
+	formatter := resolvePageMarkerFormatter(&PageConfig{
+		MarkerFormat: StringPtr("### Page {page_num} ###"),
+		Formatter:    HTMLAnchorFormatter{},
+	})
+
+	got := formatter.Format(PageMeta{PageNum: 7})
+	want := "<a id=\"page-7\"></a>\n"
+	if got != want {
+		t.Fatalf("Format() = %q, want %q", got, want)
+	}
+}
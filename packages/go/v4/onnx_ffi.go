@@ -0,0 +1,49 @@
+package kreuzberg
+
+/*
+#cgo LDFLAGS: -lkreuzberg_onnx_ffi
+#include <stdlib.h>
+#include "kreuzberg_onnx_ffi.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// ffiDownloadModel fetches preset's model files into modelPath, optionally
+// via mirrorURL, and verifies their checksum once downloaded.
+func ffiDownloadModel(modelPath, preset, mirrorURL string) error {
+	cPath := C.CString(modelPath)
+	defer C.free(unsafe.Pointer(cPath))
+	cPreset := C.CString(preset)
+	defer C.free(unsafe.Pointer(cPreset))
+	cMirror := C.CString(mirrorURL)
+	defer C.free(unsafe.Pointer(cMirror))
+
+	var cErr *C.char
+	C.kreuzberg_onnx_download_model(cPath, cPreset, cMirror, &cErr)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return errors.New(C.GoString(cErr))
+	}
+	return nil
+}
+
+// ffiVerifyModelChecksum validates a cached model's files against their
+// published checksum, returning an error if they don't match.
+func ffiVerifyModelChecksum(modelPath, preset string) error {
+	cPath := C.CString(modelPath)
+	defer C.free(unsafe.Pointer(cPath))
+	cPreset := C.CString(preset)
+	defer C.free(unsafe.Pointer(cPreset))
+
+	var cErr *C.char
+	C.kreuzberg_onnx_verify_model(cPath, cPreset, &cErr)
+	if cErr != nil {
+		defer C.free(unsafe.Pointer(cErr))
+		return errors.New(C.GoString(cErr))
+	}
+	return nil
+}
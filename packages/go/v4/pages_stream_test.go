@@ -0,0 +1,68 @@
+package kreuzberg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestExtractFileStreamCancellation tests that ExtractFileStream stops
+// emitting pages and reports ctx.Err() once the context is cancelled.
+func TestExtractFileStreamCancellation(t *testing.T) {
+	dir := t.TempDir()
+	path, err := writeValidPDFToFile(dir, "sample.pdf")
+	if err != nil {
+		t.Fatalf("failed to write test PDF: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pages, errs := ExtractFileStream(ctx, path, &ExtractionConfig{
+		Pages: &PageConfig{ExtractPages: BoolPtr(true)},
+	})
+	cancel()
+
+	for range pages {
+		// drain whatever happened to already be in flight
+	}
+	if err := <-errs; err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+// TestExtractFileStreamMarkerFormat tests that streamed pages honor
+// PageConfig.MarkerFormat the same way ExtractFileSync does.
+func TestExtractFileStreamMarkerFormat(t *testing.T) {
+	dir := t.TempDir()
+	path, err := writeValidPDFToFile(dir, "sample.pdf")
+	if err != nil {
+		t.Fatalf("failed to write test PDF: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pages, errs := ExtractFileStream(ctx, path, &ExtractionConfig{
+		Pages: &PageConfig{
+			ExtractPages:      BoolPtr(true),
+			InsertPageMarkers: BoolPtr(true),
+			MarkerFormat:      StringPtr("### Page {page_num} ###"),
+		},
+	})
+
+	count := 0
+	for pr := range pages {
+		count++
+		want := fmt.Sprintf("### Page %d ###", pr.PageNum)
+		if !strings.HasPrefix(pr.Content, want) {
+			t.Fatalf("page %d content = %q, want prefix %q", pr.PageNum, pr.Content, want)
+		}
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("ExtractFileStream failed: %v", err)
+	}
+	if count == 0 {
+		t.Fatalf("expected at least one streamed page")
+	}
+}
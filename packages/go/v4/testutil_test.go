@@ -0,0 +1,34 @@
+package kreuzberg
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// minimalPDF is a tiny but structurally valid single-page PDF, good enough
+// for exercising the extraction entry points without shipping a real
+// fixture file.
+const minimalPDF = "%PDF-1.4\n" +
+	"1 0 obj<</Type/Catalog/Pages 2 0 R>>endobj\n" +
+	"2 0 obj<</Type/Pages/Kids[3 0 R]/Count 1>>endobj\n" +
+	"3 0 obj<</Type/Page/Parent 2 0 R/MediaBox[0 0 612 792]>>endobj\n" +
+	"xref\n" +
+	"0 4\n" +
+	"0000000000 65535 f \n" +
+	"0000000009 00000 n \n" +
+	"0000000052 00000 n \n" +
+	"0000000101 00000 n \n" +
+	"trailer<</Size 4/Root 1 0 R>>\n" +
+	"startxref\n" +
+	"164\n" +
+	"%%EOF"
+
+// writeValidPDFToFile writes a minimal valid PDF named name into dir and
+// returns its path.
+func writeValidPDFToFile(dir, name string) (string, error) {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(minimalPDF), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
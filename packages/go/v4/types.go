@@ -0,0 +1,45 @@
+package kreuzberg
+
+// BoolPtr returns a pointer to b, for setting optional bool fields on
+// config structs without declaring an intermediate variable.
+func BoolPtr(b bool) *bool {
+	return &b
+}
+
+// StringPtr returns a pointer to s, for setting optional string fields on
+// config structs without declaring an intermediate variable.
+func StringPtr(s string) *string {
+	return &s
+}
+
+// IntPtr returns a pointer to i, for setting optional int fields on config
+// structs without declaring an intermediate variable.
+func IntPtr(i int) *int {
+	return &i
+}
+
+// PageMeta describes a single extracted page, independent of its content.
+// It is populated by the FFI layer for every page, whether extraction runs
+// synchronously or via ExtractFileStream/ExtractBytesStream.
+type PageMeta struct {
+	PageNum    int    `json:"page_num"`
+	TotalPages int    `json:"total_pages"`
+	SourcePath string `json:"source_path,omitempty"`
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	MimeType   string `json:"mime_type,omitempty"`
+}
+
+// PageResult is the content and metadata extracted for a single page.
+type PageResult struct {
+	PageMeta
+	Content string `json:"content"`
+}
+
+// ExtractResult is the full document returned by ExtractFileSync and
+// ExtractBytesSync.
+type ExtractResult struct {
+	Content  string            `json:"content"`
+	Pages    []PageResult      `json:"pages,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}